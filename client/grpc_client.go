@@ -2,20 +2,20 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"sync"
+
 	"github.com/prometheus/common/log"
 	"google.golang.org/grpc"
-	"sync"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/irisnet/core-sdk-go/types"
 )
 
-var clientConnSingleton *grpc.ClientConn
-var once sync.Once
-
-type grpcClient struct {
-}
-
-// Token token
+// Token carries the per-RPC credentials used to authenticate against a
+// BSN project.
 type Token struct {
 	projectId        string
 	projectKey       string
@@ -38,29 +38,176 @@ func (t *Token) RequireTransportSecurity() bool {
 	return true
 }
 
-func NewGRPCClient(url string, info types.BSNProjectInfo) types.GRPCClient {
-	once.Do(func() {
+// pooledConn is a *grpc.ClientConn shared by every grpcClient dialed with
+// the same (endpoint, credentials) key, released once its last holder
+// calls Close.
+type pooledConn struct {
+	conn     *grpc.ClientConn
+	refCount int
+}
+
+// connPool keeps at most one *grpc.ClientConn per (endpoint, credential)
+// key, so that several Client instances targeting the same endpoint with
+// different BSN project tokens or transport credentials never end up
+// sharing a connection dialed for someone else's credentials, the way
+// the old clientConnSingleton let them.
+type connPool struct {
+	mu    sync.Mutex
+	conns map[string]*pooledConn
+}
+
+var pool = &connPool{conns: make(map[string]*pooledConn)}
+
+// get returns the pooled connection for key, dialing and caching a new
+// one via dial if none is pooled yet (or the pooled one has shut down).
+// Each successful call bumps the connection's refcount; callers must
+// balance it with a release.
+func (p *connPool) get(key string, dial func() (*grpc.ClientConn, error)) (*grpc.ClientConn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pc, ok := p.conns[key]; ok {
+		if pc.conn.GetState() != connectivity.Shutdown {
+			pc.refCount++
+			return pc.conn, nil
+		}
+		delete(p.conns, key)
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	p.conns[key] = &pooledConn{conn: conn, refCount: 1}
+	return conn, nil
+}
+
+// release drops one reference to key's pooled connection, closing and
+// evicting it only once every holder has released it.
+func (p *connPool) release(key string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pc, ok := p.conns[key]
+	if !ok {
+		return nil
+	}
+
+	pc.refCount--
+	if pc.refCount > 0 {
+		return nil
+	}
+
+	delete(p.conns, key)
+	return pc.conn.Close()
+}
 
-		token := Token{
+// grpcClient is a pooled, TLS-capable implementation of types.GRPCClient.
+type grpcClient struct {
+	endpoint string
+	key      string
+	conn     *grpc.ClientConn
+
+	closeOnce sync.Once
+}
+
+// NewGRPCClient dials url using the transport credentials and dial
+// options carried by opts, reusing a pooled connection already dialed
+// for the same endpoint and credentials. When info identifies a BSN
+// project, its per-RPC credentials are attached on top of the chosen
+// transport; since those credentials require transport security, a
+// secure transport must already be configured via opts or dialing fails
+// immediately instead of rejecting every RPC later.
+func NewGRPCClient(url string, info types.BSNProjectInfo, opts types.GRPCOptions) (types.GRPCClient, error) {
+	if info.ProjectId != "" && opts.TransportCredentials == nil && opts.TLSConfig == nil {
+		return nil, fmt.Errorf("grpc: BSN project credentials require a secure transport; set TLSOption or TransportCredentialsOption")
+	}
+
+	dialOpts := append([]grpc.DialOption{}, opts.DialOptions...)
+	dialOpts = append(dialOpts, grpc.WithTransportCredentials(transportCredentials(opts)))
+
+	if info.ProjectId != "" {
+		token := &Token{
 			projectId:        info.ProjectId,
 			projectKey:       info.ProjectKey,
 			chainAccountAddr: info.ChainAccountAddress,
 		}
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(token))
+	}
 
-		dialOpts := []grpc.DialOption{
-			grpc.WithInsecure(),
-			grpc.WithPerRPCCredentials(&token),
-		}
-		clientConn, err := grpc.Dial(url, dialOpts...)
-		if err != nil {
-			log.Error(err.Error())
-			panic(err)
-		}
-		clientConnSingleton = clientConn
+	key := poolKey(url, info, opts)
+	conn, err := pool.get(key, func() (*grpc.ClientConn, error) {
+		return grpc.Dial(url, dialOpts...)
 	})
-	return &grpcClient{}
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+
+	return &grpcClient{endpoint: url, key: key, conn: conn}, nil
+}
+
+// poolKey fingerprints the credentials a connection was dialed with
+// alongside its endpoint, so two callers never share a connection dialed
+// for a different BSN project token, TLS config, or transport
+// credentials.
+func poolKey(endpoint string, info types.BSNProjectInfo, opts types.GRPCOptions) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%p|%p",
+		endpoint, info.ProjectId, info.ProjectKey, info.ChainAccountAddress,
+		opts.TLSConfig, opts.TransportCredentials)
+}
+
+// transportCredentials resolves the credentials to dial with, preferring
+// an explicit TransportCredentials, then TLSConfig, and finally falling
+// back to plaintext.
+func transportCredentials(opts types.GRPCOptions) credentials.TransportCredentials {
+	if opts.TransportCredentials != nil {
+		return opts.TransportCredentials
+	}
+	if opts.TLSConfig != nil {
+		return credentials.NewTLS(opts.TLSConfig)
+	}
+	return insecure.NewCredentials()
+}
+
+func (g *grpcClient) GenConn() (*grpc.ClientConn, error) {
+	if g.conn.GetState() == connectivity.Shutdown {
+		return nil, fmt.Errorf("grpc connection to %s is closed", g.endpoint)
+	}
+	return g.conn, nil
 }
 
-func (g grpcClient) GenConn() (*grpc.ClientConn, error) {
-	return clientConnSingleton, nil
+// HealthCheck reports whether the underlying connection is ready to
+// serve RPCs, nudging it to reconnect when it is idle or transiently
+// failing.
+func (g *grpcClient) HealthCheck(ctx context.Context) error {
+	state := g.conn.GetState()
+	if state == connectivity.Ready {
+		return nil
+	}
+
+	g.conn.Connect()
+	if !g.conn.WaitForStateChange(ctx, state) {
+		return ctx.Err()
+	}
+
+	if s := g.conn.GetState(); s != connectivity.Ready && s != connectivity.Idle {
+		return fmt.Errorf("grpc connection to %s is unhealthy: %s", g.endpoint, s)
+	}
+	return nil
+}
+
+// Close releases this grpcClient's reference to its pooled connection.
+// The underlying *grpc.ClientConn is only actually closed once every
+// other grpcClient sharing it has also released its reference, so
+// closing one Client's GRPCClient never severs another Client still
+// using the same endpoint and credentials. Close is idempotent: a second
+// or later call is a no-op, so it never over-decrements the shared
+// refcount out from under a still-live grpcClient.
+func (g *grpcClient) Close() error {
+	var err error
+	g.closeOnce.Do(func() {
+		err = pool.release(g.key)
+	})
+	return err
 }
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/irisnet/core-sdk-go/types"
+)
+
+func TestConnPoolSharesAndRefcounts(t *testing.T) {
+	p := &connPool{conns: make(map[string]*pooledConn)}
+	var dials int
+	dial := func() (*grpc.ClientConn, error) {
+		dials++
+		return grpc.Dial("passthrough:///bufnet", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	conn1, err := p.get("k", dial)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	conn2, err := p.get("k", dial)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if conn1 != conn2 {
+		t.Fatalf("expected the second get to reuse the pooled connection")
+	}
+	if dials != 1 {
+		t.Fatalf("expected exactly one dial, got %d", dials)
+	}
+
+	if err := p.release("k"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if _, ok := p.conns["k"]; !ok {
+		t.Fatalf("connection released too early: another holder is still referencing it")
+	}
+
+	if err := p.release("k"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if _, ok := p.conns["k"]; ok {
+		t.Fatalf("connection should be evicted once every holder has released it")
+	}
+}
+
+func TestPoolKeyDiffersByCredentials(t *testing.T) {
+	infoA := types.BSNProjectInfo{ProjectId: "a"}
+	infoB := types.BSNProjectInfo{ProjectId: "b"}
+
+	if poolKey("addr:9090", infoA, types.GRPCOptions{}) == poolKey("addr:9090", infoB, types.GRPCOptions{}) {
+		t.Fatalf("expected different BSN project tokens to produce different pool keys")
+	}
+}
+
+func TestNewGRPCClientRejectsInsecureBSNTransport(t *testing.T) {
+	_, err := NewGRPCClient("addr:9090", types.BSNProjectInfo{ProjectId: "p"}, types.GRPCOptions{})
+	if err == nil {
+		t.Fatalf("expected an error when a BSN project is configured without a secure transport")
+	}
+}
+
+// TestGrpcClientCloseIsIdempotent guards against double-releasing a
+// shared pooled connection: closing one grpcClient twice must not evict
+// the connection out from under a second grpcClient still holding it.
+func TestGrpcClientCloseIsIdempotent(t *testing.T) {
+	p := &connPool{conns: make(map[string]*pooledConn)}
+	dial := func() (*grpc.ClientConn, error) {
+		return grpc.Dial("passthrough:///bufnet", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	oldPool := pool
+	pool = p
+	defer func() { pool = oldPool }()
+
+	conn, err := pool.get("k", dial)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	first := &grpcClient{endpoint: "addr", key: "k", conn: conn}
+
+	conn, err = pool.get("k", dial)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	second := &grpcClient{endpoint: "addr", key: "k", conn: conn}
+
+	if err := first.Close(); err != nil {
+		t.Fatalf("first Close: %v", err)
+	}
+	if err := first.Close(); err != nil {
+		t.Fatalf("second call to first.Close should be a no-op, got: %v", err)
+	}
+
+	if _, err := second.GenConn(); err != nil {
+		t.Fatalf("second grpcClient's connection should still be usable, got: %v", err)
+	}
+
+	if err := second.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+	if len(p.conns) != 0 {
+		t.Fatalf("expected the pooled connection to be evicted once every holder has closed, got %d entries", len(p.conns))
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/irisnet/core-sdk-go/common/crypto"
+	"github.com/irisnet/core-sdk-go/types"
+)
+
+// Dispatcher broadcasts already-signed transaction bytes to a node under
+// a given types.BroadcastMode. The Client's existing Mode-based
+// broadcast path implements it.
+type Dispatcher interface {
+	Dispatch(mode types.BroadcastMode, txBytes []byte) (interface{}, error)
+}
+
+// RemoteSigner signs a transaction through a crypto.RemoteKeyManager and
+// submits it through the SDK's existing Mode-based broadcast path, so a
+// tx built against a remote wallet service is dispatched exactly like
+// one signed by an in-process KeyManager.
+type RemoteSigner struct {
+	manager    *crypto.RemoteKeyManager
+	dispatcher Dispatcher
+	mode       types.BroadcastMode
+}
+
+// NewRemoteSigner returns a RemoteSigner that signs through manager and
+// submits via dispatcher using mode.
+func NewRemoteSigner(manager *crypto.RemoteKeyManager, dispatcher Dispatcher, mode types.BroadcastMode) *RemoteSigner {
+	return &RemoteSigner{manager: manager, dispatcher: dispatcher, mode: mode}
+}
+
+// SignAndDispatch signs doc with the key bound to addr, hands the
+// resulting signature and public key to assemble to produce the final
+// transaction bytes, and submits those through the dispatcher.
+func (s *RemoteSigner) SignAndDispatch(addr string, doc crypto.SignDoc, assemble func(sig, pubKey []byte) ([]byte, error)) (interface{}, error) {
+	sig, pubKey, err := s.manager.Sign(addr, doc)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer: sign failed: %w", err)
+	}
+
+	txBytes, err := assemble(sig, pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer: failed to assemble signed tx: %w", err)
+	}
+
+	return s.dispatcher.Dispatch(s.mode, txBytes)
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/irisnet/core-sdk-go/common/crypto"
+	"github.com/irisnet/core-sdk-go/types"
+)
+
+type fakeSignerClient struct {
+	sig, pubKey []byte
+	signErr     error
+}
+
+func (f *fakeSignerClient) ListAddresses(ctx context.Context) ([]string, error) { return nil, nil }
+
+func (f *fakeSignerClient) HasKey(ctx context.Context, addr string) (bool, error) { return true, nil }
+
+func (f *fakeSignerClient) Sign(ctx context.Context, addr string, doc []byte) ([]byte, []byte, error) {
+	return f.sig, f.pubKey, f.signErr
+}
+
+func (f *fakeSignerClient) Export(ctx context.Context, addr, token string) (string, error) {
+	return "", nil
+}
+
+func (f *fakeSignerClient) Import(ctx context.Context, addr, privKeyArmor, token string) error {
+	return nil
+}
+
+type fakeDispatcher struct {
+	gotMode types.BroadcastMode
+	gotTx   []byte
+}
+
+func (f *fakeDispatcher) Dispatch(mode types.BroadcastMode, txBytes []byte) (interface{}, error) {
+	f.gotMode = mode
+	f.gotTx = txBytes
+	return "ok", nil
+}
+
+func TestRemoteSignerSignAndDispatch(t *testing.T) {
+	client := &fakeSignerClient{sig: []byte("sig"), pubKey: []byte("pub")}
+	dispatcher := &fakeDispatcher{}
+	signer := NewRemoteSigner(crypto.NewRemoteKeyManager(client), dispatcher, types.Sync)
+
+	result, err := signer.SignAndDispatch("addr1", []byte("doc"), func(sig, pubKey []byte) ([]byte, error) {
+		return append(append([]byte{}, sig...), pubKey...), nil
+	})
+	if err != nil {
+		t.Fatalf("SignAndDispatch: %v", err)
+	}
+	if result != "ok" {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	if dispatcher.gotMode != types.Sync {
+		t.Fatalf("expected dispatch mode %v, got %v", types.Sync, dispatcher.gotMode)
+	}
+	if string(dispatcher.gotTx) != "sigpub" {
+		t.Fatalf("unexpected dispatched tx bytes: %q", dispatcher.gotTx)
+	}
+}
+
+func TestRemoteSignerSignFailure(t *testing.T) {
+	client := &fakeSignerClient{signErr: errors.New("boom")}
+	signer := NewRemoteSigner(crypto.NewRemoteKeyManager(client), &fakeDispatcher{}, types.Sync)
+
+	_, err := signer.SignAndDispatch("addr1", []byte("doc"), func(sig, pubKey []byte) ([]byte, error) {
+		return nil, nil
+	})
+	if err == nil {
+		t.Fatalf("expected an error when signing fails")
+	}
+}
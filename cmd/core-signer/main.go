@@ -0,0 +1,29 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/irisnet/core-sdk-go/types/store"
+)
+
+func main() {
+	var (
+		listenAddr = flag.String("listen", ":26670", "address core-signer listens on")
+		dbPath     = flag.String("db", "$HOME/irishub-sdk-go/leveldb", "LevelDB directory to host keys from")
+		authToken  = flag.String("auth-token", "", "token required by every endpoint; empty disables the check")
+	)
+	flag.Parse()
+
+	*dbPath = os.ExpandEnv(*dbPath)
+	dao, err := store.NewLevelDB(*dbPath, nil)
+	if err != nil {
+		log.Fatalf("core-signer: failed to open %s: %v", *dbPath, err)
+	}
+
+	server := NewServer(dao, sign, *authToken)
+	log.Printf("core-signer: listening on %s, keys at %s", *listenAddr, *dbPath)
+	log.Fatal(http.ListenAndServe(*listenAddr, server.Handler()))
+}
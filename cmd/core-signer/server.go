@@ -0,0 +1,187 @@
+// Package main implements core-signer, a reference remote-wallet daemon
+// that SDK consumers can point a crypto.RemoteKeyManager at instead of
+// embedding a store.KeyDAO in-process. It hosts keys in the same LevelDB
+// the SDK uses today. Signing requests get back only a signature and a
+// public key; a stored armored key leaves the daemon only through the
+// export endpoint.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/irisnet/core-sdk-go/types/store"
+)
+
+var errBadToken = errors.New("invalid or missing auth token")
+
+func errNoSuchKey(addr string) error {
+	return fmt.Errorf("no key for address %s", addr)
+}
+
+// splitKeyPath splits the "/keys/{addr}[/{action}]" suffix of a request
+// path into its address and optional action.
+func splitKeyPath(path string) (addr, action string) {
+	trimmed := strings.TrimPrefix(path, "/keys/")
+	parts := strings.SplitN(trimmed, "/", 2)
+	addr = parts[0]
+	if len(parts) == 2 {
+		action = parts[1]
+	}
+	return addr, action
+}
+
+// bearerToken extracts the token from a "Authorization: Bearer <token>"
+// header, matching what crypto.HTTPSignerClient sends on every request.
+func bearerToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
+// Signer derives a signature and public key from an armored private key,
+// without the daemon needing to understand which curve produced it.
+type Signer func(privKeyArmor string, doc []byte) (sig, pubKey []byte, err error)
+
+// Server is the HTTP+JSON reference implementation of the core-signer
+// protocol consumed by crypto.HTTPSignerClient.
+type Server struct {
+	dao       store.KeyDAO
+	sign      Signer
+	authToken string
+}
+
+// NewServer returns a Server backed by dao. authToken gates every
+// endpoint; a zero value disables the check.
+func NewServer(dao store.KeyDAO, sign Signer, authToken string) *Server {
+	return &Server{dao: dao, sign: sign, authToken: authToken}
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/addresses", s.handleListAddresses)
+	mux.HandleFunc("/keys/", s.handleKey)
+	return mux
+}
+
+func (s *Server) handleListAddresses(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeError(w, http.StatusForbidden, errBadToken)
+		return
+	}
+
+	addrs, err := s.dao.List()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, addrs)
+}
+
+func (s *Server) handleKey(w http.ResponseWriter, r *http.Request) {
+	addr, action := splitKeyPath(r.URL.Path)
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusForbidden, errBadToken)
+		return
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		writeJSON(w, struct {
+			HasKey bool `json:"hasKey"`
+		}{HasKey: s.dao.Has(addr)})
+	case action == "sign" && r.Method == http.MethodPost:
+		s.handleSign(w, r, addr)
+	case action == "export" && r.Method == http.MethodPost:
+		s.handleExport(w, r, addr)
+	case action == "import" && r.Method == http.MethodPost:
+		s.handleImport(w, r, addr)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) handleSign(w http.ResponseWriter, r *http.Request, addr string) {
+	var req struct {
+		SignDoc []byte `json:"signDoc"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	privKeyArmor, err := s.dao.Read(addr)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if privKeyArmor == "" {
+		writeError(w, http.StatusNotFound, errNoSuchKey(addr))
+		return
+	}
+
+	sig, pubKey, err := s.sign(privKeyArmor, req.SignDoc)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, struct {
+		Sig    []byte `json:"sig"`
+		PubKey []byte `json:"pubKey"`
+	}{Sig: sig, PubKey: pubKey})
+}
+
+func (s *Server) handleExport(w http.ResponseWriter, r *http.Request, addr string) {
+	privKeyArmor, err := s.dao.Read(addr)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, struct {
+		PrivKeyArmor string `json:"privKeyArmor"`
+	}{PrivKeyArmor: privKeyArmor})
+}
+
+func (s *Server) handleImport(w http.ResponseWriter, r *http.Request, addr string) {
+	var req struct {
+		PrivKeyArmor string `json:"privKeyArmor"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if err := s.dao.Write(addr, req.PrivKeyArmor); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// authorized reports whether r carries this daemon's auth token as a
+// Bearer credential. Every endpoint is gated by it, Sign included: it is
+// the single most sensitive operation the daemon exposes, since it is
+// what lets a caller move funds with a hosted key.
+func (s *Server) authorized(r *http.Request) bool {
+	return s.authToken == "" || bearerToken(r) == s.authToken
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{Error: err.Error()})
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeDAO struct {
+	keys map[string]string
+}
+
+func newFakeDAO() *fakeDAO { return &fakeDAO{keys: map[string]string{}} }
+
+func (f *fakeDAO) Read(key string) (string, error)      { return f.keys[key], nil }
+func (f *fakeDAO) Write(key, privKeyArmor string) error { f.keys[key] = privKeyArmor; return nil }
+func (f *fakeDAO) Delete(key string) error              { delete(f.keys, key); return nil }
+func (f *fakeDAO) Has(key string) bool                  { _, ok := f.keys[key]; return ok }
+func (f *fakeDAO) List() ([]string, error) {
+	addrs := make([]string, 0, len(f.keys))
+	for k := range f.keys {
+		addrs = append(addrs, k)
+	}
+	return addrs, nil
+}
+
+func fakeSign(privKeyArmor string, doc []byte) ([]byte, []byte, error) {
+	return []byte("sig:" + string(doc)), []byte("pub:" + privKeyArmor), nil
+}
+
+func newTestServer(authToken string) (*httptest.Server, *fakeDAO) {
+	dao := newFakeDAO()
+	dao.keys["addr1"] = "armor1"
+	srv := NewServer(dao, fakeSign, authToken)
+	return httptest.NewServer(srv.Handler()), dao
+}
+
+func doRequest(t *testing.T, method, url, token string, body interface{}) *http.Response {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("marshal request: %v", err)
+		}
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+	return resp
+}
+
+func TestHandleSignRequiresAuth(t *testing.T) {
+	ts, _ := newTestServer("secret")
+	defer ts.Close()
+
+	resp := doRequest(t, http.MethodPost, ts.URL+"/keys/addr1/sign", "", struct {
+		SignDoc []byte `json:"signDoc"`
+	}{SignDoc: []byte("doc")})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 for a sign request without a token, got %s", resp.Status)
+	}
+}
+
+func TestHandleSignWrongTokenRejected(t *testing.T) {
+	ts, _ := newTestServer("secret")
+	defer ts.Close()
+
+	resp := doRequest(t, http.MethodPost, ts.URL+"/keys/addr1/sign", "wrong", struct {
+		SignDoc []byte `json:"signDoc"`
+	}{SignDoc: []byte("doc")})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 for a sign request with the wrong token, got %s", resp.Status)
+	}
+}
+
+func TestHandleSignAuthorized(t *testing.T) {
+	ts, _ := newTestServer("secret")
+	defer ts.Close()
+
+	resp := doRequest(t, http.MethodPost, ts.URL+"/keys/addr1/sign", "secret", struct {
+		SignDoc []byte `json:"signDoc"`
+	}{SignDoc: []byte("doc")})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 for an authorized sign request, got %s", resp.Status)
+	}
+
+	var got struct {
+		Sig    []byte `json:"sig"`
+		PubKey []byte `json:"pubKey"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if string(got.Sig) != "sig:doc" || string(got.PubKey) != "pub:armor1" {
+		t.Fatalf("unexpected sig/pubKey: %q/%q", got.Sig, got.PubKey)
+	}
+}
+
+func TestHandleListAddressesRequiresAuth(t *testing.T) {
+	ts, _ := newTestServer("secret")
+	defer ts.Close()
+
+	resp := doRequest(t, http.MethodGet, ts.URL+"/addresses", "", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 for /addresses without a token, got %s", resp.Status)
+	}
+}
+
+func TestHandleKeyHasKeyRequiresAuth(t *testing.T) {
+	ts, _ := newTestServer("secret")
+	defer ts.Close()
+
+	resp := doRequest(t, http.MethodGet, ts.URL+"/keys/addr1", "", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 for a HasKey request without a token, got %s", resp.Status)
+	}
+}
+
+func TestHandleImportThenExportRoundTrip(t *testing.T) {
+	ts, dao := newTestServer("secret")
+	defer ts.Close()
+
+	resp := doRequest(t, http.MethodPost, ts.URL+"/keys/addr2/import", "secret", struct {
+		PrivKeyArmor string `json:"privKeyArmor"`
+	}{PrivKeyArmor: "armor2"})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 for an authorized import, got %s", resp.Status)
+	}
+	if dao.keys["addr2"] != "armor2" {
+		t.Fatalf("expected import to write the key, got %q", dao.keys["addr2"])
+	}
+
+	resp = doRequest(t, http.MethodPost, ts.URL+"/keys/addr2/export", "secret", nil)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 for an authorized export, got %s", resp.Status)
+	}
+
+	var got struct {
+		PrivKeyArmor string `json:"privKeyArmor"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.PrivKeyArmor != "armor2" {
+		t.Fatalf("expected exported key %q, got %q", "armor2", got.PrivKeyArmor)
+	}
+}
+
+func TestNoAuthTokenAllowsEverything(t *testing.T) {
+	ts, _ := newTestServer("")
+	defer ts.Close()
+
+	resp := doRequest(t, http.MethodGet, ts.URL+"/addresses", "", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 when no auth token is configured, got %s", resp.Status)
+	}
+}
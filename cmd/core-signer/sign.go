@@ -0,0 +1,12 @@
+package main
+
+import "github.com/irisnet/core-sdk-go/common/crypto"
+
+// sign derives a signature and public key from an armored private key. It
+// delegates to crypto.SignWithArmor, the same keystore signing codec the
+// in-process, KeyDAO-backed KeyManager already uses for a config's Algo
+// (secp256k1 or sm2), so a key minted by the SDK today verifies
+// identically whether it is signed in-process or through core-signer.
+func sign(privKeyArmor string, doc []byte) (sig, pubKey []byte, err error) {
+	return crypto.SignWithArmor(privKeyArmor, doc)
+}
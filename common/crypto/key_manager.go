@@ -0,0 +1,29 @@
+package crypto
+
+// SignDoc is the canonical bytes a KeyManager is asked to sign. The SDK
+// builds it from a transaction; a KeyManager never needs to understand
+// its contents beyond the bytes themselves.
+type SignDoc []byte
+
+// KeyManager abstracts where private keys live and how signing happens,
+// so the SDK can work against an in-process keystore or a remote wallet
+// service without the caller-facing broadcast API changing.
+type KeyManager interface {
+	// ListAddresses returns the bech32 addresses the manager can sign for.
+	ListAddresses() ([]string, error)
+
+	// HasKey reports whether the manager holds a key for addr.
+	HasKey(addr string) (bool, error)
+
+	// Sign signs doc with the key bound to addr, returning the signature
+	// and the corresponding public key bytes.
+	Sign(addr string, doc SignDoc) (sig []byte, pubKey []byte, err error)
+
+	// Export returns the armored private key bound to addr. token
+	// authorizes the export against implementations that gate it.
+	Export(addr, token string) (privKeyArmor string, err error)
+
+	// Import adds privKeyArmor under addr. token authorizes the import
+	// against implementations that gate it.
+	Import(addr, privKeyArmor, token string) error
+}
@@ -0,0 +1,32 @@
+package crypto
+
+import (
+	"fmt"
+
+	cosmoscrypto "github.com/cosmos/cosmos-sdk/crypto"
+)
+
+// unlockPassphrase decrypts privKeyArmor blobs handed to core-signer.
+// Keys hosted there are unlocked once at rest rather than re-prompted
+// for a passphrase on every sign call over the wire; a production
+// deployment that needs a per-key passphrase should front this with its
+// own KMS and swap it in here.
+const unlockPassphrase = ""
+
+// SignWithArmor decrypts privKeyArmor and signs doc with it, returning
+// the signature and the corresponding public key bytes. It is the same
+// keystore signing codec an in-process, KeyDAO-backed KeyManager uses
+// for a config's Algo (secp256k1 or sm2), so a key minted by the SDK
+// verifies identically whether it is signed in-process or remotely.
+func SignWithArmor(privKeyArmor string, doc []byte) (sig, pubKey []byte, err error) {
+	privKey, _, err := cosmoscrypto.UnarmorDecryptPrivKey(privKeyArmor, unlockPassphrase)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to unarmor private key: %w", err)
+	}
+
+	sig, err = privKey.Sign(doc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign: %w", err)
+	}
+	return sig, privKey.PubKey().Bytes(), nil
+}
@@ -0,0 +1,118 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// HTTPSignerClient implements RemoteSignerClient over HTTP+JSON against a
+// core-signer daemon (see cmd/core-signer). authToken is sent as a Bearer
+// token on every request except Export and Import, which send the token
+// passed to them instead. It is the only credential the SDK process
+// holds; the daemon is the one that keeps private keys.
+type HTTPSignerClient struct {
+	baseURL    string
+	authToken  string
+	httpClient *http.Client
+}
+
+// NewHTTPSignerClient returns a RemoteSignerClient that talks to a
+// core-signer daemon listening at baseURL.
+func NewHTTPSignerClient(baseURL, authToken string) *HTTPSignerClient {
+	return &HTTPSignerClient{
+		baseURL:    baseURL,
+		authToken:  authToken,
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (c *HTTPSignerClient) ListAddresses(ctx context.Context) ([]string, error) {
+	var addrs []string
+	if err := c.do(ctx, http.MethodGet, "/addresses", c.authToken, nil, &addrs); err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
+
+func (c *HTTPSignerClient) HasKey(ctx context.Context, addr string) (bool, error) {
+	var resp struct {
+		HasKey bool `json:"hasKey"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/keys/"+addr, c.authToken, nil, &resp); err != nil {
+		return false, err
+	}
+	return resp.HasKey, nil
+}
+
+func (c *HTTPSignerClient) Sign(ctx context.Context, addr string, doc []byte) ([]byte, []byte, error) {
+	req := struct {
+		SignDoc []byte `json:"signDoc"`
+	}{SignDoc: doc}
+	var resp struct {
+		Sig    []byte `json:"sig"`
+		PubKey []byte `json:"pubKey"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/keys/"+addr+"/sign", c.authToken, req, &resp); err != nil {
+		return nil, nil, err
+	}
+	return resp.Sig, resp.PubKey, nil
+}
+
+func (c *HTTPSignerClient) Export(ctx context.Context, addr, token string) (string, error) {
+	var resp struct {
+		PrivKeyArmor string `json:"privKeyArmor"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/keys/"+addr+"/export", token, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.PrivKeyArmor, nil
+}
+
+func (c *HTTPSignerClient) Import(ctx context.Context, addr, privKeyArmor, token string) error {
+	req := struct {
+		PrivKeyArmor string `json:"privKeyArmor"`
+	}{PrivKeyArmor: privKeyArmor}
+	return c.do(ctx, http.MethodPost, "/keys/"+addr+"/import", token, req, nil)
+}
+
+// do issues an HTTP request against the daemon, authenticating it with
+// token as a Bearer credential in the Authorization header — the only
+// place the server looks for it, for every endpoint including Sign.
+func (c *HTTPSignerClient) do(ctx context.Context, method, path, token string, reqBody, respBody interface{}) error {
+	var body bytes.Reader
+	if reqBody != nil {
+		b, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = *bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("core-signer: %s %s: unexpected status %s", method, path, resp.Status)
+	}
+	if respBody == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(respBody)
+}
+
+var _ RemoteSignerClient = (*HTTPSignerClient)(nil)
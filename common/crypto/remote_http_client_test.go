@@ -0,0 +1,90 @@
+package crypto
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPSignerClientSign(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Authorization"), "Bearer tok"; got != want {
+			t.Errorf("Authorization header = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Path, "/keys/addr1/sign"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+
+		var req struct {
+			SignDoc []byte `json:"signDoc"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if string(req.SignDoc) != "doc" {
+			t.Errorf("signDoc = %q, want %q", req.SignDoc, "doc")
+		}
+
+		_ = json.NewEncoder(w).Encode(struct {
+			Sig    []byte `json:"sig"`
+			PubKey []byte `json:"pubKey"`
+		}{Sig: []byte("sig"), PubKey: []byte("pub")})
+	}))
+	defer srv.Close()
+
+	client := NewHTTPSignerClient(srv.URL, "tok")
+	sig, pubKey, err := client.Sign(context.Background(), "addr1", []byte("doc"))
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if string(sig) != "sig" || string(pubKey) != "pub" {
+		t.Fatalf("unexpected sig/pubKey: %q/%q", sig, pubKey)
+	}
+}
+
+func TestHTTPSignerClientNonOKStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	client := NewHTTPSignerClient(srv.URL, "")
+	if _, err := client.ListAddresses(context.Background()); err == nil {
+		t.Fatalf("expected an error for a non-200 response")
+	}
+}
+
+func TestRemoteKeyManagerDelegatesToClient(t *testing.T) {
+	fake := &fakeSignerClient{addrs: []string{"addr1", "addr2"}}
+	manager := NewRemoteKeyManager(fake)
+
+	addrs, err := manager.ListAddresses()
+	if err != nil {
+		t.Fatalf("ListAddresses: %v", err)
+	}
+	if len(addrs) != 2 || addrs[0] != "addr1" {
+		t.Fatalf("unexpected addresses: %v", addrs)
+	}
+}
+
+type fakeSignerClient struct {
+	addrs []string
+}
+
+func (f *fakeSignerClient) ListAddresses(ctx context.Context) ([]string, error) { return f.addrs, nil }
+
+func (f *fakeSignerClient) HasKey(ctx context.Context, addr string) (bool, error) { return false, nil }
+
+func (f *fakeSignerClient) Sign(ctx context.Context, addr string, doc []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeSignerClient) Export(ctx context.Context, addr, token string) (string, error) {
+	return "", nil
+}
+
+func (f *fakeSignerClient) Import(ctx context.Context, addr, privKeyArmor, token string) error {
+	return nil
+}
@@ -0,0 +1,51 @@
+package crypto
+
+import "context"
+
+// RemoteSignerClient is the wire contract a RemoteKeyManager speaks to an
+// external wallet service. HTTPSignerClient is the only implementation in
+// this package; no gRPC client for it is provided here. Any transport that
+// implements these methods can back a RemoteKeyManager.
+type RemoteSignerClient interface {
+	ListAddresses(ctx context.Context) ([]string, error)
+	HasKey(ctx context.Context, addr string) (bool, error)
+	Sign(ctx context.Context, addr string, doc []byte) (sig, pubKey []byte, err error)
+	Export(ctx context.Context, addr, token string) (privKeyArmor string, err error)
+	Import(ctx context.Context, addr, privKeyArmor, token string) error
+}
+
+// RemoteKeyManager delegates every KeyManager operation to an external
+// wallet service via client. The SDK process only ever formats SignDocs
+// and forwards them over client; it never sees a raw private key. This
+// makes it suitable for air-gapped signing or a shared HSM-backed wallet
+// used by multiple SDK consumers.
+type RemoteKeyManager struct {
+	c RemoteSignerClient
+}
+
+// NewRemoteKeyManager wraps client as a KeyManager.
+func NewRemoteKeyManager(client RemoteSignerClient) *RemoteKeyManager {
+	return &RemoteKeyManager{c: client}
+}
+
+func (m *RemoteKeyManager) ListAddresses() ([]string, error) {
+	return m.c.ListAddresses(context.Background())
+}
+
+func (m *RemoteKeyManager) HasKey(addr string) (bool, error) {
+	return m.c.HasKey(context.Background(), addr)
+}
+
+func (m *RemoteKeyManager) Sign(addr string, doc SignDoc) (sig []byte, pubKey []byte, err error) {
+	return m.c.Sign(context.Background(), addr, doc)
+}
+
+func (m *RemoteKeyManager) Export(addr, token string) (string, error) {
+	return m.c.Export(context.Background(), addr, token)
+}
+
+func (m *RemoteKeyManager) Import(addr, privKeyArmor, token string) error {
+	return m.c.Import(context.Background(), addr, privKeyArmor, token)
+}
+
+var _ KeyManager = (*RemoteKeyManager)(nil)
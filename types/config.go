@@ -1,9 +1,13 @@
 package types
 
 import (
+	"crypto/tls"
 	"fmt"
 	"os"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
 	"github.com/irisnet/core-sdk-go/common/crypto"
 	"github.com/irisnet/core-sdk-go/types/store"
 )
@@ -78,6 +82,15 @@ type ClientConfig struct {
 
 	// BSN ProjectId ProjectKey ChainAccountAddress
 	BSNProject BSNProjectInfo
+
+	// GRPCOptions configures the transport credentials and dial options
+	// used to reach GRPCAddr. The zero value dials in plaintext.
+	GRPCOptions GRPCOptions
+
+	// ChainUpgrades picks the codec and signing-mode variant to build and
+	// decode txs with, keyed by the chain height a Client observes. The
+	// zero value means the chain has never upgraded.
+	ChainUpgrades UpgradeSchedule
 }
 
 type BSNProjectInfo struct {
@@ -331,3 +344,31 @@ func BSNProjectInfoOption(info BSNProjectInfo) Option {
 	}
 
 }
+
+// TLSOption enables server-TLS (or mTLS, when cfg carries client
+// certificates) for the GRPCAddr connection. It is ignored when
+// TransportCredentialsOption is also set.
+func TLSOption(cfg *tls.Config) Option {
+	return func(c *ClientConfig) error {
+		c.GRPCOptions.TLSConfig = cfg
+		return nil
+	}
+}
+
+// TransportCredentialsOption overrides the gRPC transport credentials
+// outright, taking precedence over TLSOption.
+func TransportCredentialsOption(creds credentials.TransportCredentials) Option {
+	return func(c *ClientConfig) error {
+		c.GRPCOptions.TransportCredentials = creds
+		return nil
+	}
+}
+
+// GRPCDialOptions appends extra grpc.DialOption values, e.g. keepalive
+// parameters or interceptors, to the dial of GRPCAddr.
+func GRPCDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *ClientConfig) error {
+		c.GRPCOptions.DialOptions = append(c.GRPCOptions.DialOptions, opts...)
+		return nil
+	}
+}
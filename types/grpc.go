@@ -0,0 +1,39 @@
+package types
+
+import (
+	"context"
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// GRPCClient abstracts the gRPC transport used to reach a node, so that
+// it can be pooled, health-checked and closed independently of the SDK
+// Client that consumes it.
+type GRPCClient interface {
+	// GenConn returns the connection backing this client.
+	GenConn() (*grpc.ClientConn, error)
+
+	// HealthCheck reports whether the connection is ready to serve RPCs,
+	// triggering a reconnect attempt when it currently isn't.
+	HealthCheck(ctx context.Context) error
+
+	// Close releases the connection. Other GRPCClients dialed to the
+	// same endpoint may continue to use it until they also Close.
+	Close() error
+}
+
+// GRPCOptions configures the transport used to dial a node's gRPC
+// endpoint. The zero value dials in plaintext.
+type GRPCOptions struct {
+	// TLSConfig enables server-TLS, or mTLS when it carries client
+	// certificates. Ignored when TransportCredentials is set.
+	TLSConfig *tls.Config
+
+	// TransportCredentials, when set, takes precedence over TLSConfig.
+	TransportCredentials credentials.TransportCredentials
+
+	// DialOptions are appended to every dial of this endpoint.
+	DialOptions []grpc.DialOption
+}
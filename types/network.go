@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Network names a registered set of ClientConfig defaults for connecting
+// to a particular chain environment (mainnet, a public testnet, a local
+// devnet, or an environment registered by a chain built on this SDK).
+type Network string
+
+const (
+	// MainnetNetwork is the IRISHub mainnet.
+	MainnetNetwork Network = "mainnet"
+
+	// TestnetNetwork is the IRISHub Nyancat public testnet.
+	TestnetNetwork Network = "testnet"
+
+	// DevnetNetwork is a local, single-node IRISHub devnet.
+	DevnetNetwork Network = "devnet"
+)
+
+// NetworkPreset bundles the ClientConfig defaults for a chain
+// environment, so callers no longer have to hand-assemble NodeURI,
+// GRPCAddr, ChainID, Bech32AddressPrefix, BIP44Path, Fee and Algo
+// themselves for every environment they connect to.
+type NetworkPreset struct {
+	ChainID             string
+	NodeURI             string
+	GRPCAddr            string
+	Bech32AddressPrefix AddrPrefixCfg
+	BIP44Path           string
+	DefaultFee          string
+	Algo                string
+}
+
+// networkPresetsMu guards networkPresets, which RegisterNetwork can write
+// from an init() in any downstream package while NetworkOption reads it
+// concurrently during client construction.
+var networkPresetsMu sync.RWMutex
+
+var networkPresets = map[Network]NetworkPreset{
+	MainnetNetwork: {
+		ChainID:             "irishub-1",
+		NodeURI:             "http://rpc-mainnet.irisnet.org:80",
+		GRPCAddr:            "grpc-mainnet.irisnet.org:9090",
+		Bech32AddressPrefix: *PrefixCfg,
+		BIP44Path:           FullPath,
+		DefaultFee:          defaultFees,
+		Algo:                defaultAlgo,
+	},
+	TestnetNetwork: {
+		ChainID:             "nyancat-9",
+		NodeURI:             "http://rpc-testnet.irisnet.org:80",
+		GRPCAddr:            "grpc-testnet.irisnet.org:9090",
+		Bech32AddressPrefix: *PrefixCfg,
+		BIP44Path:           FullPath,
+		DefaultFee:          defaultFees,
+		Algo:                defaultAlgo,
+	},
+	DevnetNetwork: {
+		ChainID:             "irishub-devnet",
+		NodeURI:             "http://localhost:26657",
+		GRPCAddr:            "localhost:9090",
+		Bech32AddressPrefix: *PrefixCfg,
+		BIP44Path:           FullPath,
+		DefaultFee:          defaultFees,
+		Algo:                defaultAlgo,
+	},
+}
+
+// RegisterNetwork makes preset available to NetworkOption under name,
+// overwriting any existing preset registered under the same name. Chains
+// built on this SDK use it to register their own environments alongside
+// the built-in IRISHub ones.
+func RegisterNetwork(name Network, preset NetworkPreset) {
+	networkPresetsMu.Lock()
+	defer networkPresetsMu.Unlock()
+	networkPresets[name] = preset
+}
+
+func lookupNetwork(name Network) (NetworkPreset, error) {
+	networkPresetsMu.RLock()
+	defer networkPresetsMu.RUnlock()
+
+	preset, ok := networkPresets[name]
+	if !ok {
+		return NetworkPreset{}, fmt.Errorf("no network preset registered for %q", name)
+	}
+	return preset, nil
+}
+
+// NetworkOption selects a registered NetworkPreset's defaults for
+// NodeURI, GRPCAddr, ChainID, Bech32AddressPrefix, BIP44Path, Fee and
+// Algo. It only fills in fields that are still zero-valued, so it can be
+// combined with NewClientConfig's positional arguments or another Option
+// in the same chain, which take precedence.
+func NetworkOption(network Network) Option {
+	return func(cfg *ClientConfig) error {
+		preset, err := lookupNetwork(network)
+		if err != nil {
+			return err
+		}
+
+		if cfg.NodeURI == "" {
+			cfg.NodeURI = preset.NodeURI
+		}
+		if cfg.GRPCAddr == "" {
+			cfg.GRPCAddr = preset.GRPCAddr
+		}
+		if cfg.ChainID == "" {
+			cfg.ChainID = preset.ChainID
+		}
+		if cfg.Bech32AddressPrefix.AccountAddr == "" && cfg.Bech32AddressPrefix.ValidatorAddr == "" && cfg.Bech32AddressPrefix.ConsensusAddr == "" {
+			cfg.Bech32AddressPrefix = preset.Bech32AddressPrefix
+		}
+		if cfg.BIP44Path == "" {
+			cfg.BIP44Path = preset.BIP44Path
+		}
+		if cfg.Algo == "" {
+			cfg.Algo = preset.Algo
+		}
+		if cfg.Fee == nil || cfg.Fee.Empty() {
+			fee, err := ParseDecCoins(preset.DefaultFee)
+			if err != nil {
+				return err
+			}
+			cfg.Fee = fee
+		}
+		return nil
+	}
+}
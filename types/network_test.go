@@ -0,0 +1,52 @@
+package types
+
+import "testing"
+
+func TestNetworkOptionFillsOnlyZeroFields(t *testing.T) {
+	RegisterNetwork("chunk0-3-test", NetworkPreset{
+		ChainID:    "test-1",
+		NodeURI:    "http://preset",
+		GRPCAddr:   "preset:9090",
+		BIP44Path:  "44'/999'/0'/0/0",
+		DefaultFee: defaultFees,
+		Algo:       defaultAlgo,
+	})
+
+	cfg := ClientConfig{NodeURI: "http://explicit"}
+	if err := NetworkOption("chunk0-3-test")(&cfg); err != nil {
+		t.Fatalf("NetworkOption: %v", err)
+	}
+
+	if cfg.NodeURI != "http://explicit" {
+		t.Fatalf("expected the already-set NodeURI to win over the preset, got %q", cfg.NodeURI)
+	}
+	if cfg.ChainID != "test-1" {
+		t.Fatalf("expected ChainID to be filled from the preset, got %q", cfg.ChainID)
+	}
+	if cfg.GRPCAddr != "preset:9090" {
+		t.Fatalf("expected GRPCAddr to be filled from the preset, got %q", cfg.GRPCAddr)
+	}
+}
+
+func TestLookupNetworkUnknown(t *testing.T) {
+	if _, err := lookupNetwork("does-not-exist"); err == nil {
+		t.Fatalf("expected an error for an unregistered network")
+	}
+}
+
+// TestRegisterNetworkConcurrentAccess exercises RegisterNetwork and
+// lookupNetwork concurrently; run with -race to catch a regression to an
+// unsynchronized networkPresets map.
+func TestRegisterNetworkConcurrentAccess(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			RegisterNetwork("chunk0-3-race", NetworkPreset{ChainID: "race"})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		_, _ = lookupNetwork("chunk0-3-race")
+	}
+	<-done
+}
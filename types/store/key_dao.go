@@ -0,0 +1,66 @@
+package store
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// KeyDAO persists and retrieves armored private keys, keyed by the
+// address or name the caller chooses to store them under.
+type KeyDAO interface {
+	Read(key string) (string, error)
+	Write(key, privKeyArmor string) error
+	Delete(key string) error
+	Has(key string) bool
+	List() ([]string, error)
+}
+
+// levelDB is the default, on-disk KeyDAO implementation.
+type levelDB struct {
+	db *leveldb.DB
+}
+
+// NewLevelDB opens (creating if necessary) a LevelDB-backed KeyDAO at
+// path. A nil o uses LevelDB's defaults.
+func NewLevelDB(path string, o *opt.Options) (KeyDAO, error) {
+	db, err := leveldb.OpenFile(path, o)
+	if err != nil {
+		return nil, err
+	}
+	return &levelDB{db: db}, nil
+}
+
+func (l *levelDB) Read(key string) (string, error) {
+	v, err := l.db.Get([]byte(key), nil)
+	if err != nil {
+		if err == leveldb.ErrNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(v), nil
+}
+
+func (l *levelDB) Write(key, privKeyArmor string) error {
+	return l.db.Put([]byte(key), []byte(privKeyArmor), nil)
+}
+
+func (l *levelDB) Delete(key string) error {
+	return l.db.Delete([]byte(key), nil)
+}
+
+func (l *levelDB) Has(key string) bool {
+	ok, _ := l.db.Has([]byte(key), nil)
+	return ok
+}
+
+func (l *levelDB) List() ([]string, error) {
+	iter := l.db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	var keys []string
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	return keys, iter.Error()
+}
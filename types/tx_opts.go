@@ -0,0 +1,73 @@
+package types
+
+import "context"
+
+// TxOpts carries per-call overrides for a single broadcast or simulate
+// request. Passing AccountNumber/Sequence explicitly lets a caller build
+// and sign a transaction without the Client fetching and bumping the
+// account sequence itself, which is what makes concurrent use of a
+// single Client from multiple goroutines safe. Any field left at its
+// zero value falls back to the matching ClientConfig default.
+type TxOpts struct {
+	// Context bounds how long this call may run and carries cancellation
+	// down to the underlying node request.
+	Context context.Context
+
+	// AccountNumber and Sequence pin the signer's account metadata for
+	// this call instead of querying it from the node, enabling offline
+	// signing and parallel tx building.
+	AccountNumber uint64
+	Sequence      uint64
+
+	// Gas overrides ClientConfig.Gas for this call.
+	Gas uint64
+
+	// Fee overrides ClientConfig.Fee for this call.
+	Fee DecCoins
+
+	// GasAdjustment overrides ClientConfig.GasAdjustment for this call.
+	GasAdjustment float64
+
+	// FeeGranter, when set, is billed for fees instead of the signer.
+	FeeGranter string
+
+	// Memo overrides the transaction memo for this call.
+	Memo string
+
+	// TimeoutHeight, when non-zero, rejects the transaction once the
+	// chain passes this block height.
+	TimeoutHeight uint64
+
+	// Mode overrides ClientConfig.Mode for this call.
+	Mode BroadcastMode
+
+	// Simulate runs gas estimation only; nothing is signed or broadcast.
+	Simulate bool
+
+	// NoBroadcast builds and signs the transaction but returns its bytes
+	// instead of submitting it, for offline signing or submission through
+	// another path.
+	NoBroadcast bool
+}
+
+// WithDefaults returns a copy of opts with zero-valued Gas, Fee,
+// GasAdjustment and Mode filled in from cfg, and a non-nil Context when
+// none was supplied. The other fields are returned as given.
+func (opts TxOpts) WithDefaults(cfg ClientConfig) TxOpts {
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+	if opts.Gas == 0 {
+		opts.Gas = cfg.Gas
+	}
+	if opts.Fee == nil || opts.Fee.Empty() {
+		opts.Fee = cfg.Fee
+	}
+	if opts.GasAdjustment == 0 {
+		opts.GasAdjustment = cfg.GasAdjustment
+	}
+	if opts.Mode == "" {
+		opts.Mode = cfg.Mode
+	}
+	return opts
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTxOptsWithDefaultsFillsZeroFields(t *testing.T) {
+	cfg := ClientConfig{
+		Gas:           500000,
+		GasAdjustment: 1.5,
+		Mode:          Sync,
+	}
+
+	opts := TxOpts{}.WithDefaults(cfg)
+
+	if opts.Context == nil {
+		t.Fatalf("expected a non-nil Context when none was supplied")
+	}
+	if opts.Gas != cfg.Gas {
+		t.Fatalf("Gas = %d, want %d", opts.Gas, cfg.Gas)
+	}
+	if opts.GasAdjustment != cfg.GasAdjustment {
+		t.Fatalf("GasAdjustment = %v, want %v", opts.GasAdjustment, cfg.GasAdjustment)
+	}
+	if opts.Mode != cfg.Mode {
+		t.Fatalf("Mode = %q, want %q", opts.Mode, cfg.Mode)
+	}
+}
+
+func TestTxOptsWithDefaultsLeavesSetFieldsAlone(t *testing.T) {
+	cfg := ClientConfig{
+		Gas:           500000,
+		GasAdjustment: 1.5,
+		Mode:          Sync,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	want := TxOpts{
+		Context:       ctx,
+		Gas:           100,
+		GasAdjustment: 2.0,
+		Mode:          BroadcastMode("async"),
+	}
+	opts := want.WithDefaults(cfg)
+
+	if opts.Context != ctx {
+		t.Fatalf("expected the caller-supplied Context to be left untouched")
+	}
+	if opts.Gas != want.Gas {
+		t.Fatalf("Gas = %d, want the caller-supplied %d", opts.Gas, want.Gas)
+	}
+	if opts.GasAdjustment != want.GasAdjustment {
+		t.Fatalf("GasAdjustment = %v, want the caller-supplied %v", opts.GasAdjustment, want.GasAdjustment)
+	}
+	if opts.Mode != want.Mode {
+		t.Fatalf("Mode = %q, want the caller-supplied %q", opts.Mode, want.Mode)
+	}
+}
+
+func TestTxOptsWithDefaultsFeeFallback(t *testing.T) {
+	fee, err := ParseDecCoins(defaultFees)
+	if err != nil {
+		t.Fatalf("ParseDecCoins: %v", err)
+	}
+	cfg := ClientConfig{Fee: fee}
+
+	opts := TxOpts{}.WithDefaults(cfg)
+	if !opts.Fee.IsEqual(cfg.Fee) {
+		t.Fatalf("Fee = %v, want the ClientConfig default %v", opts.Fee, cfg.Fee)
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import "testing"
+
+func TestUpgradeScheduleHandlerForHeight(t *testing.T) {
+	v2 := UpgradeHandler{Name: "v2-fee-market"}
+	v3 := UpgradeHandler{Name: "v3-textual-signing", SignMode: "SIGN_MODE_TEXTUAL"}
+
+	schedule := NewUpgradeSchedule(map[int64]UpgradeHandler{
+		100: v2,
+		200: v3,
+	})
+
+	cases := []struct {
+		height int64
+		want   string
+	}{
+		{height: 0, want: ""},
+		{height: 99, want: ""},
+		{height: 100, want: "v2-fee-market"},
+		{height: 150, want: "v2-fee-market"},
+		{height: 200, want: "v3-textual-signing"},
+		{height: 1000, want: "v3-textual-signing"},
+	}
+
+	for _, c := range cases {
+		if got := schedule.HandlerForHeight(c.height).Name; got != c.want {
+			t.Errorf("HandlerForHeight(%d) = %q, want %q", c.height, got, c.want)
+		}
+	}
+}
+
+func TestUpgradeScheduleEmpty(t *testing.T) {
+	var schedule UpgradeSchedule
+	if got := schedule.HandlerForHeight(12345).Name; got != "" {
+		t.Errorf("expected the zero UpgradeHandler for an empty schedule, got %q", got)
+	}
+}
+
+func TestUpgradeScheduleOutOfOrderInsertion(t *testing.T) {
+	schedule := NewUpgradeSchedule(map[int64]UpgradeHandler{
+		300: {Name: "third"},
+		100: {Name: "first"},
+		200: {Name: "second"},
+	})
+
+	if got := schedule.HandlerForHeight(250).Name; got != "second" {
+		t.Errorf("HandlerForHeight(250) = %q, want %q", got, "second")
+	}
+}